@@ -0,0 +1,136 @@
+// Package auth provides a Gin middleware that authenticates requests using
+// ed25519-signed JWTs and enforces scope-based route protection.
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Config holds the settings needed to verify incoming tokens.
+type Config struct {
+	// KeyPath is the path to a PEM file containing an ed25519 public key.
+	KeyPath string
+	// Audience is the expected `aud` claim.
+	Audience string
+	// Issuer is the expected `iss` claim.
+	Issuer string
+}
+
+// Claims is the set of JWT claims this service expects, on top of the
+// standard registered claims.
+type Claims struct {
+	Scope []string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+// HasScope reports whether the claims grant the given scope.
+func (c Claims) HasScope(scope string) bool {
+	for _, s := range c.Scope {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadKey PEM-decodes keyPEM, x509-parses the PKIX public key it contains,
+// and returns it as an ed25519.PublicKey. It rejects keys of any other type
+// and any non-whitespace data after the PEM block; trailing whitespace is
+// accepted.
+func LoadKey(keyPEM string) (ed25519.PublicKey, error) {
+	block, rest := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("auth: no PEM block found")
+	}
+	if len(strings.TrimSpace(string(rest))) != 0 {
+		return nil, errors.New("auth: trailing data after PEM block")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("auth: failed to parse public key: %w", err)
+	}
+
+	key, ok := pub.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("auth: expected ed25519 public key, got %T", pub)
+	}
+	return key, nil
+}
+
+// Middleware verifies the bearer token on incoming requests against key and
+// the configured audience/issuer, then returns a gin.HandlerFunc that
+// additionally requires requiredScope.
+type Middleware struct {
+	key    ed25519.PublicKey
+	config Config
+}
+
+// NewMiddleware creates a Middleware backed by key and config.
+func NewMiddleware(key ed25519.PublicKey, config Config) *Middleware {
+	return &Middleware{key: key, config: config}
+}
+
+// RequireScope returns a gin.HandlerFunc that parses and verifies the bearer
+// token, checks for requiredScope, and on success attaches the parsed claims
+// to the context under the "claims" key.
+func (m *Middleware) RequireScope(requiredScope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, err := m.parseToken(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+			return
+		}
+
+		if !claims.HasScope(requiredScope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "missing required scope: " + requiredScope})
+			return
+		}
+
+		c.Set("claims", claims)
+		c.Next()
+	}
+}
+
+// parseToken extracts the bearer token from the Authorization header and
+// validates its signature and standard claims.
+func (m *Middleware) parseToken(c *gin.Context) (*Claims, error) {
+	header := c.GetHeader("Authorization")
+	if header == "" {
+		return nil, errors.New("auth: missing Authorization header")
+	}
+
+	parts := strings.SplitN(header, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return nil, errors.New("auth: Authorization header must be a Bearer token")
+	}
+
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, fmt.Errorf("auth: unexpected signing method: %v", token.Header["alg"])
+		}
+		return m.key, nil
+	},
+		jwt.WithAudience(m.config.Audience),
+		jwt.WithIssuer(m.config.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("auth: invalid token: %w", err)
+	}
+	if !token.Valid {
+		return nil, errors.New("auth: invalid token")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// batchWorkerPoolSize bounds how many documents are scored concurrently in
+// a single batch request.
+const batchWorkerPoolSize = 8
+
+// BatchHandler holds dependencies for the batch scoring API.
+type BatchHandler struct {
+	defaultScorer Scorer
+	registry      *ScorerRegistry
+}
+
+// NewBatchHandler creates a BatchHandler using defaultScorer when a request
+// doesn't specify a strategy, and registry to resolve named strategies.
+func NewBatchHandler(defaultScorer Scorer, registry *ScorerRegistry) *BatchHandler {
+	return &BatchHandler{defaultScorer: defaultScorer, registry: registry}
+}
+
+// batchDocument is a single item in a batch scoring request.
+type batchDocument struct {
+	ID           string `json:"id"`
+	DocumentText string `json:"documentText"`
+}
+
+// batchScoreRequest is the JSON body accepted by PostBatchScore.
+type batchScoreRequest struct {
+	Documents []batchDocument `json:"documents" binding:"required"`
+	Strategy  string          `json:"strategy"`
+}
+
+// batchScoreResult is a single item in a batch scoring response.
+type batchScoreResult struct {
+	ID string `json:"id"`
+	ScoreResponse
+}
+
+// PostBatchScore is the Gin handler function for POST /v1/score/batch.
+// It scores each document using the requested strategy, fanning work out
+// over a bounded worker pool while preserving input order in the response.
+func (h *BatchHandler) PostBatchScore(c *gin.Context) {
+	var req batchScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	scorer := h.defaultScorer
+	if req.Strategy != "" {
+		var ok bool
+		scorer, ok = h.registry.Get(req.Strategy)
+		if !ok {
+			names := h.registry.Names()
+			sort.Strings(names)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"message":    "Unknown scoring strategy: " + req.Strategy,
+				"registered": names,
+			})
+			return
+		}
+	}
+
+	results := make([]batchScoreResult, len(req.Documents))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	workers := batchWorkerPoolSize
+	if workers > len(req.Documents) {
+		workers = len(req.Documents)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				doc := req.Documents[i]
+				results[i] = batchScoreResult{
+					ID: doc.ID,
+					ScoreResponse: ScoreResponse{
+						WordCount: countWordsFor(scorer, doc.DocumentText),
+						Score:     scorer.CalculateScore(doc.DocumentText),
+						Message:   "Scoring successful",
+					},
+				}
+			}
+		}()
+	}
+	for i := range req.Documents {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// countWordsFor returns the word count for text, reusing WordScorer's
+// whitespace-tokenizing definition regardless of which Scorer computed the
+// score.
+func countWordsFor(scorer Scorer, text string) int {
+	if ws, ok := scorer.(*WordScorer); ok {
+		return ws.CountWords(text)
+	}
+	return len(strings.Fields(text))
+}
+
+// GetScorers is the Gin handler function for GET /v1/scorers. It lists the
+// names of all registered scoring strategies.
+func (h *BatchHandler) GetScorers(c *gin.Context) {
+	names := h.registry.Names()
+	sort.Strings(names)
+	c.JSON(http.StatusOK, gin.H{"scorers": names})
+}
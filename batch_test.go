@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPostBatchScorePreservesOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	scorer, err := NewWordScorer(WordScorerConfig{MinScore: 0, MaxScore: 100, ThresholdWordsForMaxScore: 10})
+	if err != nil {
+		t.Fatalf("NewWordScorer: %v", err)
+	}
+	registry := NewScorerRegistry(scorer)
+	handler := NewBatchHandler(scorer, registry)
+
+	body, _ := json.Marshal(batchScoreRequest{
+		Documents: []batchDocument{
+			{ID: "c", DocumentText: "one two three four five six seven eight nine ten"},
+			{ID: "a", DocumentText: "one"},
+			{ID: "b", DocumentText: ""},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest("POST", "/v1/score/batch", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	handler.PostBatchScore(c)
+
+	var resp struct {
+		Results []batchScoreResult `json:"results"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+
+	if len(resp.Results) != 3 {
+		t.Fatalf("got %d results, want 3", len(resp.Results))
+	}
+	wantOrder := []string{"c", "a", "b"}
+	for i, id := range wantOrder {
+		if resp.Results[i].ID != id {
+			t.Errorf("Results[%d].ID = %q, want %q (input order must be preserved)", i, resp.Results[i].ID, id)
+		}
+	}
+	if resp.Results[0].Score != 100 {
+		t.Errorf("Results[0].Score = %d, want 100 for a 10-word document", resp.Results[0].Score)
+	}
+}
+
+func TestGetScorersListsRegisteredStrategies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	scorer, _ := NewWordScorer(WordScorerConfig{MinScore: 0, MaxScore: 100, ThresholdWordsForMaxScore: 10})
+	registry := NewScorerRegistry(scorer, NewReadabilityScorer())
+	handler := NewBatchHandler(scorer, registry)
+
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest("GET", "/v1/scorers", nil)
+
+	handler.GetScorers(c)
+
+	var resp struct {
+		Scorers []string `json:"scorers"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if len(resp.Scorers) != 2 {
+		t.Fatalf("got %d scorers, want 2", len(resp.Scorers))
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"time"
+)
+
+// AuthConfig holds the flag/env driven settings for the auth middleware.
+type AuthConfig struct {
+	KeyPath  string
+	Audience string
+	Issuer   string
+}
+
+// authFlags holds the flag values backing AuthConfig until flag.Parse runs.
+type authFlags struct {
+	keyPath  *string
+	audience *string
+	issuer   *string
+}
+
+// registerAuthFlags registers the auth flags, falling back to environment
+// variables and then sane defaults.
+func registerAuthFlags() authFlags {
+	return authFlags{
+		keyPath:  flag.String("auth-key", envOrDefault("AUTH_KEY_PATH", "keys/auth_ed25519.pub.pem"), "path to the ed25519 public key PEM file"),
+		audience: flag.String("auth-audience", envOrDefault("AUTH_AUDIENCE", "ptb-scoring-system"), "expected JWT audience"),
+		issuer:   flag.String("auth-issuer", envOrDefault("AUTH_ISSUER", "ptb-scoring-system"), "expected JWT issuer"),
+	}
+}
+
+// resolve converts the parsed flag values into an AuthConfig. Call after
+// flag.Parse.
+func (f authFlags) resolve() AuthConfig {
+	return AuthConfig{
+		KeyPath:  *f.keyPath,
+		Audience: *f.audience,
+		Issuer:   *f.issuer,
+	}
+}
+
+// envOrDefault returns the value of the environment variable key, or def if
+// it is unset.
+func envOrDefault(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
+
+// envDurationOrDefault returns the environment variable key parsed as a
+// time.Duration, or def if it is unset or unparsable.
+func envDurationOrDefault(key string, def time.Duration) time.Duration {
+	raw, ok := os.LookupEnv(key)
+	if !ok {
+		return def
+	}
+	parsed, err := time.ParseDuration(raw)
+	if err != nil {
+		return def
+	}
+	return parsed
+}
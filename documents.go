@@ -0,0 +1,164 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// appVersion is reported by the healthcheck endpoint.
+const appVersion = "1.0.0"
+
+// DocumentsHandler holds dependencies for the v1 documents resource.
+type DocumentsHandler struct {
+	store  Store
+	scorer *WordScorer
+}
+
+// NewDocumentsHandler creates a DocumentsHandler backed by store and scorer.
+func NewDocumentsHandler(store Store, scorer *WordScorer) *DocumentsHandler {
+	return &DocumentsHandler{store: store, scorer: scorer}
+}
+
+// documentRequest is the JSON body accepted by create and update.
+type documentRequest struct {
+	Title string `json:"title" binding:"required"`
+	Text  string `json:"documentText" binding:"required"`
+}
+
+// CreateDocument is the Gin handler function for POST /v1/documents.
+// It scores the supplied text and persists the resulting document.
+func (h *DocumentsHandler) CreateDocument(c *gin.Context) {
+	var req documentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	now := time.Now()
+	doc := &Document{
+		Title:     req.Title,
+		Text:      req.Text,
+		Score:     h.scorer.CalculateScore(req.Text),
+		WordCount: h.scorer.CountWords(req.Text),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := h.store.Insert(doc); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to create document: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"document": doc})
+}
+
+// GetDocument is the Gin handler function for GET /v1/documents/:id.
+func (h *DocumentsHandler) GetDocument(c *gin.Context) {
+	doc, err := h.store.Get(c.Param("id"))
+	if err != nil {
+		h.respondStoreError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"document": doc})
+}
+
+// UpdateDocument is the Gin handler function for PUT /v1/documents/:id.
+// It rescores the document with the supplied text.
+func (h *DocumentsHandler) UpdateDocument(c *gin.Context) {
+	doc, err := h.store.Get(c.Param("id"))
+	if err != nil {
+		h.respondStoreError(c, err)
+		return
+	}
+
+	var req documentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	doc.Title = req.Title
+	doc.Text = req.Text
+	doc.Score = h.scorer.CalculateScore(req.Text)
+	doc.WordCount = h.scorer.CountWords(req.Text)
+	doc.UpdatedAt = time.Now()
+
+	if err := h.store.Update(doc); err != nil {
+		h.respondStoreError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"document": doc})
+}
+
+// DeleteDocument is the Gin handler function for DELETE /v1/documents/:id.
+func (h *DocumentsHandler) DeleteDocument(c *gin.Context) {
+	if err := h.store.Delete(c.Param("id")); err != nil {
+		h.respondStoreError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Document deleted"})
+}
+
+// ListDocuments is the Gin handler function for GET /v1/documents.
+// It supports filtering by title and min_score, pagination, and sort.
+func (h *DocumentsHandler) ListDocuments(c *gin.Context) {
+	filters, err := parseFilters(
+		c.Query("title"),
+		c.Query("min_score"),
+		c.Query("page"),
+		c.Query("page_size"),
+		c.Query("sort"),
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	docs, total, err := h.store.List(filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to list documents: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"documents": docs,
+		"metadata":  calculateMetadata(total, filters.Page, filters.PageSize),
+	})
+}
+
+// respondStoreError translates a Store error into the appropriate HTTP
+// response.
+func (h *DocumentsHandler) respondStoreError(c *gin.Context, err error) {
+	if errors.Is(err, ErrDocumentNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "Document not found"})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+}
+
+// HealthcheckHandler returns the app version and scorer configuration.
+type HealthcheckHandler struct {
+	scorerConfig WordScorerConfig
+}
+
+// NewHealthcheckHandler creates a HealthcheckHandler reporting scorerConfig.
+func NewHealthcheckHandler(scorerConfig WordScorerConfig) *HealthcheckHandler {
+	return &HealthcheckHandler{scorerConfig: scorerConfig}
+}
+
+// GetHealthcheck is the Gin handler function for GET /v1/healthcheck.
+func (h *HealthcheckHandler) GetHealthcheck(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "available",
+		"version": appVersion,
+		"scorerConfig": gin.H{
+			"minScore":                  h.scorerConfig.MinScore,
+			"maxScore":                  h.scorerConfig.MaxScore,
+			"thresholdWordsForMaxScore": h.scorerConfig.ThresholdWordsForMaxScore,
+		},
+	})
+}
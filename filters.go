@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// maxPageSize caps how many records a single page can request.
+const maxPageSize = 100
+
+// sortSafelist is the set of columns clients may sort documents by. Using a
+// safelist instead of passing the sort field straight through prevents SQL
+// injection once a database-backed Store is introduced.
+var sortSafelist = map[string]bool{
+	"score":       true,
+	"-score":      true,
+	"title":       true,
+	"-title":      true,
+	"created_at":  true,
+	"-created_at": true,
+}
+
+// Filters holds the validated query parameters for listing documents.
+type Filters struct {
+	Title    string
+	MinScore int
+	Page     int
+	PageSize int
+	Sort     string
+}
+
+// parseFilters builds a Filters from raw query parameters, applying
+// defaults and validating page, page_size, and sort.
+func parseFilters(title, minScoreRaw, pageRaw, pageSizeRaw, sortRaw string) (Filters, error) {
+	filters := Filters{
+		Title:    title,
+		Page:     1,
+		PageSize: 20,
+		Sort:     "score",
+	}
+
+	if minScoreRaw != "" {
+		minScore, err := strconv.Atoi(minScoreRaw)
+		if err != nil {
+			return Filters{}, fmt.Errorf("min_score must be an integer")
+		}
+		filters.MinScore = minScore
+	}
+
+	if pageRaw != "" {
+		page, err := strconv.Atoi(pageRaw)
+		if err != nil || page < 1 {
+			return Filters{}, fmt.Errorf("page must be a positive integer")
+		}
+		filters.Page = page
+	}
+
+	if pageSizeRaw != "" {
+		pageSize, err := strconv.Atoi(pageSizeRaw)
+		if err != nil || pageSize < 1 {
+			return Filters{}, fmt.Errorf("page_size must be a positive integer")
+		}
+		if pageSize > maxPageSize {
+			pageSize = maxPageSize
+		}
+		filters.PageSize = pageSize
+	}
+
+	if sortRaw != "" {
+		if !sortSafelist[sortRaw] {
+			return Filters{}, fmt.Errorf("invalid sort column: %s", sortRaw)
+		}
+		filters.Sort = sortRaw
+	}
+
+	return filters, nil
+}
+
+// Metadata is the pagination envelope returned alongside list responses.
+type Metadata struct {
+	CurrentPage  int `json:"current_page"`
+	PageSize     int `json:"page_size"`
+	TotalRecords int `json:"total_records"`
+	LastPage     int `json:"last_page"`
+}
+
+// calculateMetadata builds a Metadata envelope for totalRecords given the
+// current page and page size.
+func calculateMetadata(totalRecords, page, pageSize int) Metadata {
+	if totalRecords == 0 {
+		return Metadata{}
+	}
+	lastPage := (totalRecords + pageSize - 1) / pageSize
+	return Metadata{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: totalRecords,
+		LastPage:     lastPage,
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestParseFiltersDefaults(t *testing.T) {
+	filters, err := parseFilters("", "", "", "", "")
+	if err != nil {
+		t.Fatalf("parseFilters: %v", err)
+	}
+	if filters.Page != 1 || filters.PageSize != 20 || filters.Sort != "score" {
+		t.Fatalf("parseFilters defaults = %+v, want Page=1 PageSize=20 Sort=score", filters)
+	}
+}
+
+func TestParseFiltersCapsPageSize(t *testing.T) {
+	filters, err := parseFilters("", "", "", "500", "")
+	if err != nil {
+		t.Fatalf("parseFilters: %v", err)
+	}
+	if filters.PageSize != maxPageSize {
+		t.Fatalf("PageSize = %d, want capped at %d", filters.PageSize, maxPageSize)
+	}
+}
+
+func TestParseFiltersRejectsInvalidSortColumn(t *testing.T) {
+	if _, err := parseFilters("", "", "", "", "'; DROP TABLE documents;--"); err == nil {
+		t.Fatal("parseFilters accepted a sort column outside the safelist, want error")
+	}
+}
+
+func TestParseFiltersAcceptsSafelistedSortColumns(t *testing.T) {
+	for sort := range sortSafelist {
+		if _, err := parseFilters("", "", "", "", sort); err != nil {
+			t.Errorf("parseFilters rejected safelisted sort %q: %v", sort, err)
+		}
+	}
+}
+
+func TestParseFiltersRejectsNonPositivePage(t *testing.T) {
+	if _, err := parseFilters("", "", "0", "", ""); err == nil {
+		t.Fatal("parseFilters accepted page=0, want error")
+	}
+}
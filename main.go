@@ -1,12 +1,16 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"math"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/WillisRH/ptb-scoring-system/auth"
 )
 
 // --- Scoring Logic (unchanged) ---
@@ -88,13 +92,15 @@ type ScoreResponse struct {
 
 // ScoreHandler holds dependencies for the scoring API handlers.
 type ScoreHandler struct {
-	scorer *WordScorer
+	scorer     *WordScorer
+	scoreboard *Scoreboard
 }
 
 // NewScoreHandler creates a new ScoreHandler with its dependencies.
-func NewScoreHandler(scorer *WordScorer) *ScoreHandler {
+func NewScoreHandler(scorer *WordScorer, scoreboard *Scoreboard) *ScoreHandler {
 	return &ScoreHandler{
-		scorer: scorer,
+		scorer:     scorer,
+		scoreboard: scoreboard,
 	}
 }
 
@@ -123,6 +129,14 @@ func (h *ScoreHandler) PostScore(c *gin.Context) {
 	wordCount := h.scorer.CountWords(text)
 	score := h.scorer.CalculateScore(text)
 
+	if h.scoreboard != nil {
+		if raw, ok := c.Get("claims"); ok {
+			if claims, ok := raw.(*auth.Claims); ok && claims.Subject != "" {
+				h.scoreboard.Add(claims.Subject, score)
+			}
+		}
+	}
+
 	response := ScoreResponse{
 		WordCount: wordCount,
 		Score:     score,
@@ -137,6 +151,29 @@ func (h *ScoreHandler) PostScore(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// defaultLeaderboardSize is how many entries GetScoreboard returns. The
+// endpoint takes no limit parameter.
+const defaultLeaderboardSize = 10
+
+// GetScoreboard is the Gin handler function for GET /v1/scoreboard.
+// It returns the top-N leaderboard ordered by descending score.
+func (h *ScoreHandler) GetScoreboard(c *gin.Context) {
+	top := h.scoreboard.Top(defaultLeaderboardSize)
+	c.JSON(http.StatusOK, gin.H{"leaderboard": top})
+}
+
+// GetScoreboardEntry is the Gin handler function for GET /v1/scoreboard/:id.
+// It returns the cumulative score for a single submitter.
+func (h *ScoreHandler) GetScoreboardEntry(c *gin.Context) {
+	id := c.Param("id")
+	score, ok := h.scoreboard.Get(id)
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"message": "No score recorded for submitter"})
+		return
+	}
+	c.JSON(http.StatusOK, ScoreboardEntry{SubmitterID: id, Score: score})
+}
+
 func main() {
 	// 1. Initialize the Scorer
 	scorerConfig := WordScorerConfig{
@@ -151,23 +188,84 @@ func main() {
 	log.Printf("Scorer initialized: MinScore=%d, MaxScore=%d, Threshold=%d words",
 		scorerConfig.MinScore, scorerConfig.MaxScore, scorerConfig.ThresholdWordsForMaxScore)
 
-	// 2. Initialize the Handler
-	scoreAPIHandler := NewScoreHandler(appScorer)
+	// 2. Initialize the Scoreboard
+	scoreboard, err := NewScoreboard("scoreboard.json")
+	if err != nil {
+		log.Fatalf("Failed to initialize scoreboard: %v", err)
+	}
+	defer scoreboard.Close()
+
+	// 3. Initialize the Handler
+	scoreAPIHandler := NewScoreHandler(appScorer, scoreboard)
 
-	// 3. Initialize Gin router
+	// 4. Initialize the auth middleware
+	authFlagValues := registerAuthFlags()
+	httpFlagValues := registerHTTPFlags()
+	flag.Parse()
+	authConfig := authFlagValues.resolve()
+	httpConfig := httpFlagValues.resolve()
+
+	keyPEM, err := os.ReadFile(authConfig.KeyPath)
+	if err != nil {
+		log.Fatalf("Failed to read auth key file: %v", err)
+	}
+	authKey, err := auth.LoadKey(string(keyPEM))
+	if err != nil {
+		log.Fatalf("Failed to load auth key: %v", err)
+	}
+	authMiddleware := auth.NewMiddleware(authKey, auth.Config{
+		KeyPath:  authConfig.KeyPath,
+		Audience: authConfig.Audience,
+		Issuer:   authConfig.Issuer,
+	})
+
+	// 5. Initialize the document store and handlers
+	documentStore := NewInMemoryStore()
+	documentsHandler := NewDocumentsHandler(documentStore, appScorer)
+	healthcheckHandler := NewHealthcheckHandler(scorerConfig)
+	scoreHub := NewHub()
+
+	// 5b. Initialize the scorer registry and batch handler
+	scorerRegistry := NewScorerRegistry(
+		appScorer,
+		NewSentenceScorer(SentenceScorerConfig{MinScore: 20, MaxScore: 40, ThresholdSentencesForMaxScore: 5}),
+		NewReadabilityScorer(),
+	)
+	batchHandler := NewBatchHandler(appScorer, scorerRegistry)
+
+	// 6. Initialize Gin router
 	router := gin.Default()
+	useCORS(httpConfig, router)
 
-	// 4. Define API routes under an /api group
-	apiRoutes := router.Group("/api")
+	// 7. Define API routes under a versioned /v1 group
+	v1 := router.Group("/v1")
 	{
-		apiRoutes.POST("/score", scoreAPIHandler.PostScore) // Use the method from the handler instance
-		// You could add more routes here, e.g., apiRoutes.GET("/config", scoreAPIHandler.GetConfig)
+		v1.GET("/healthcheck", healthcheckHandler.GetHealthcheck)
+
+		v1.POST("/score", authMiddleware.RequireScope("score:write"), scoreAPIHandler.PostScore)
+		v1.POST("/score/batch", authMiddleware.RequireScope("score:write"), batchHandler.PostBatchScore)
+		v1.GET("/score/ws", authMiddleware.RequireScope("score:write"), func(c *gin.Context) {
+			scoreHub.ServeWS(appScorer, c)
+		})
+		v1.GET("/scorers", authMiddleware.RequireScope("score:read"), batchHandler.GetScorers)
+		v1.GET("/scoreboard", authMiddleware.RequireScope("score:read"), scoreAPIHandler.GetScoreboard)
+		v1.GET("/scoreboard/:id", authMiddleware.RequireScope("score:read"), scoreAPIHandler.GetScoreboardEntry)
+
+		documents := v1.Group("/documents")
+		{
+			documents.POST("", authMiddleware.RequireScope("score:write"), documentsHandler.CreateDocument)
+			documents.GET("", authMiddleware.RequireScope("score:read"), documentsHandler.ListDocuments)
+			documents.GET("/:id", authMiddleware.RequireScope("score:read"), documentsHandler.GetDocument)
+			documents.PUT("/:id", authMiddleware.RequireScope("score:write"), documentsHandler.UpdateDocument)
+			documents.DELETE("/:id", authMiddleware.RequireScope("score:write"), documentsHandler.DeleteDocument)
+		}
 	}
 
-	// 5. Start the server
-	port := "8080"
-	log.Printf("Starting server on port %s, API available at /api/score", port)
-	if err := router.Run(":" + port); err != nil {
+	// 8. Harden and start the server
+	server := newHTTPServer(httpConfig, router)
+	log.Printf("Starting server on port %s, API available at /v1", httpConfig.Port)
+	if err := runWithGracefulShutdown(server); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
-}
\ No newline at end of file
+	log.Println("Server shut down gracefully")
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// --- Scoreboard Subsystem ---
+
+// scoreboardSaveInterval controls how often the in-memory scoreboard is
+// flushed to disk.
+const scoreboardSaveInterval = 10 * time.Second
+
+// ScoreboardEntry is a single row of the leaderboard.
+type ScoreboardEntry struct {
+	SubmitterID string `json:"submitterId"`
+	Score       int    `json:"score"`
+}
+
+// Scoreboard tracks cumulative scores per submitter and periodically
+// flushes them to a backing file.
+type Scoreboard struct {
+	path string
+
+	scoresLock sync.Mutex
+	scoresM    map[string]int
+
+	ticker *time.Ticker
+	done   chan struct{}
+	wg     sync.WaitGroup
+
+	// saveLoopWaitCh is signaled after each save loop flush so tests can
+	// synchronize with the background goroutine without sleeping.
+	saveLoopWaitCh chan struct{}
+}
+
+// NewScoreboard creates a Scoreboard backed by path, loading any existing
+// scores from disk and starting the periodic save loop.
+func NewScoreboard(path string) (*Scoreboard, error) {
+	return newScoreboard(path, scoreboardSaveInterval)
+}
+
+// newScoreboard is the shared constructor behind NewScoreboard, parameterized
+// on the save interval so tests can flush on a much shorter cadence.
+func newScoreboard(path string, saveInterval time.Duration) (*Scoreboard, error) {
+	sb := &Scoreboard{
+		path:           path,
+		scoresM:        make(map[string]int),
+		ticker:         time.NewTicker(saveInterval),
+		done:           make(chan struct{}),
+		saveLoopWaitCh: make(chan struct{}, 1),
+	}
+
+	if err := sb.load(); err != nil {
+		return nil, err
+	}
+
+	sb.wg.Add(1)
+	go sb.saveLoop()
+
+	return sb, nil
+}
+
+// load reads the backing file into scoresM, tolerating a missing or empty file.
+func (sb *Scoreboard) load() error {
+	data, err := os.ReadFile(sb.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	sb.scoresLock.Lock()
+	defer sb.scoresLock.Unlock()
+	return json.Unmarshal(data, &sb.scoresM)
+}
+
+// Add records score for submitterID, accumulating onto any previous score,
+// and returns the submitter's new cumulative total.
+func (sb *Scoreboard) Add(submitterID string, score int) int {
+	sb.scoresLock.Lock()
+	defer sb.scoresLock.Unlock()
+	sb.scoresM[submitterID] += score
+	return sb.scoresM[submitterID]
+}
+
+// Get returns the cumulative score for submitterID and whether it exists.
+func (sb *Scoreboard) Get(submitterID string) (int, bool) {
+	sb.scoresLock.Lock()
+	defer sb.scoresLock.Unlock()
+	score, ok := sb.scoresM[submitterID]
+	return score, ok
+}
+
+// Top returns the top n submitters sorted by descending score.
+func (sb *Scoreboard) Top(n int) []ScoreboardEntry {
+	sb.scoresLock.Lock()
+	snapshot := make([]ScoreboardEntry, 0, len(sb.scoresM))
+	for id, score := range sb.scoresM {
+		snapshot = append(snapshot, ScoreboardEntry{SubmitterID: id, Score: score})
+	}
+	sb.scoresLock.Unlock()
+
+	sort.Slice(snapshot, func(i, j int) bool {
+		if snapshot[i].Score != snapshot[j].Score {
+			return snapshot[i].Score > snapshot[j].Score
+		}
+		return snapshot[i].SubmitterID < snapshot[j].SubmitterID
+	})
+
+	if n >= 0 && n < len(snapshot) {
+		snapshot = snapshot[:n]
+	}
+	return snapshot
+}
+
+// saveLoop periodically snapshots scoresM and flushes it to disk until Close
+// is called.
+func (sb *Scoreboard) saveLoop() {
+	defer sb.wg.Done()
+	for {
+		select {
+		case <-sb.ticker.C:
+			if err := sb.save(); err != nil {
+				log.Printf("scoreboard: failed to save: %v", err)
+			}
+			select {
+			case sb.saveLoopWaitCh <- struct{}{}:
+			default:
+			}
+		case <-sb.done:
+			return
+		}
+	}
+}
+
+// save snapshots scoresM under scoresLock, then writes it to disk without
+// holding the lock so scoring requests are never blocked on I/O.
+func (sb *Scoreboard) save() error {
+	sb.scoresLock.Lock()
+	snapshot := make(map[string]int, len(sb.scoresM))
+	for id, score := range sb.scoresM {
+		snapshot[id] = score
+	}
+	sb.scoresLock.Unlock()
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(sb.path, data, 0o644)
+}
+
+// Close stops the save loop and performs a final flush.
+func (sb *Scoreboard) Close() error {
+	sb.ticker.Stop()
+	close(sb.done)
+	sb.wg.Wait()
+	return sb.save()
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestScoreboardAddAndGet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scoreboard.json")
+	sb, err := NewScoreboard(path)
+	if err != nil {
+		t.Fatalf("NewScoreboard: %v", err)
+	}
+	defer sb.Close()
+
+	if total := sb.Add("alice", 10); total != 10 {
+		t.Fatalf("Add() = %d, want 10", total)
+	}
+	if total := sb.Add("alice", 5); total != 15 {
+		t.Fatalf("Add() = %d, want 15 after second submission", total)
+	}
+
+	score, ok := sb.Get("alice")
+	if !ok || score != 15 {
+		t.Fatalf("Get(\"alice\") = (%d, %v), want (15, true)", score, ok)
+	}
+
+	if _, ok := sb.Get("bob"); ok {
+		t.Fatalf("Get(\"bob\") found a score, want none recorded")
+	}
+}
+
+func TestScoreboardTop(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scoreboard.json")
+	sb, err := NewScoreboard(path)
+	if err != nil {
+		t.Fatalf("NewScoreboard: %v", err)
+	}
+	defer sb.Close()
+
+	sb.Add("alice", 10)
+	sb.Add("bob", 30)
+	sb.Add("carol", 20)
+
+	top := sb.Top(2)
+	if len(top) != 2 {
+		t.Fatalf("Top(2) returned %d entries, want 2", len(top))
+	}
+	if top[0].SubmitterID != "bob" || top[1].SubmitterID != "carol" {
+		t.Fatalf("Top(2) = %+v, want [bob carol] in descending score order", top)
+	}
+}
+
+func TestScoreboardLoadToleratesMissingOrEmptyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "does-not-exist.json")
+	sb, err := NewScoreboard(path)
+	if err != nil {
+		t.Fatalf("NewScoreboard with missing file: %v", err)
+	}
+	sb.Close()
+
+	path = filepath.Join(t.TempDir(), "empty.json")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+	sb, err = NewScoreboard(path)
+	if err != nil {
+		t.Fatalf("NewScoreboard with empty file: %v", err)
+	}
+	sb.Close()
+}
+
+func TestScoreboardSaveLoopFlushesAndRestorePreservesState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scoreboard.json")
+
+	sb, err := newScoreboard(path, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("newScoreboard: %v", err)
+	}
+	sb.Add("alice", 42)
+
+	select {
+	case <-sb.saveLoopWaitCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("save loop never flushed within the expected interval")
+	}
+	sb.Close()
+
+	restarted, err := NewScoreboard(path)
+	if err != nil {
+		t.Fatalf("NewScoreboard on restart: %v", err)
+	}
+	defer restarted.Close()
+
+	score, ok := restarted.Get("alice")
+	if !ok || score != 42 {
+		t.Fatalf("Get(\"alice\") after restart = (%d, %v), want (42, true)", score, ok)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Scorer calculates a score for a piece of text. Implementations register
+// themselves with a ScorerRegistry under a unique Name so callers can select
+// a strategy by name (e.g. via the batch scoring endpoint's "strategy"
+// field).
+type Scorer interface {
+	CalculateScore(text string) int
+	Name() string
+}
+
+// Name identifies this WordScorer as the "word-count" strategy.
+func (s *WordScorer) Name() string {
+	return "word-count"
+}
+
+// SentenceScorerConfig holds the configuration for the SentenceScorer.
+type SentenceScorerConfig struct {
+	MinScore                      int
+	MaxScore                      int
+	ThresholdSentencesForMaxScore int
+}
+
+// SentenceScorer calculates scores based on sentence count, interpolating
+// between MinScore and MaxScore the same way WordScorer does for words.
+type SentenceScorer struct {
+	config SentenceScorerConfig
+}
+
+// NewSentenceScorer creates a new SentenceScorer with the given configuration.
+func NewSentenceScorer(config SentenceScorerConfig) *SentenceScorer {
+	return &SentenceScorer{config: config}
+}
+
+// sentenceBoundary splits text into sentences on ./!/? punctuation.
+var sentenceBoundary = regexp.MustCompile(`[.!?]+`)
+
+// CountSentences splits the text on sentence-ending punctuation and returns
+// the number of non-empty sentences.
+func (s *SentenceScorer) CountSentences(text string) int {
+	count := 0
+	for _, sentence := range sentenceBoundary.Split(text, -1) {
+		if strings.TrimSpace(sentence) != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// CalculateScore calculates the score for the given text based on sentence count.
+func (s *SentenceScorer) CalculateScore(text string) int {
+	sentenceCount := s.CountSentences(text)
+
+	if sentenceCount == 0 {
+		return s.config.MinScore
+	}
+	if sentenceCount >= s.config.ThresholdSentencesForMaxScore {
+		return s.config.MaxScore
+	}
+
+	scoreRange := float64(s.config.MaxScore - s.config.MinScore)
+	progress := float64(sentenceCount) / float64(s.config.ThresholdSentencesForMaxScore)
+	calculatedScore := float64(s.config.MinScore) + (progress * scoreRange)
+
+	finalScore := int(math.Round(calculatedScore))
+	if finalScore < s.config.MinScore {
+		return s.config.MinScore
+	}
+	if finalScore > s.config.MaxScore {
+		return s.config.MaxScore
+	}
+	return finalScore
+}
+
+// Name identifies this scorer as the "sentence-count" strategy.
+func (s *SentenceScorer) Name() string {
+	return "sentence-count"
+}
+
+// ReadabilityScorer scores text using the Flesch Reading Ease formula,
+// clamped to [0, 100].
+type ReadabilityScorer struct{}
+
+// NewReadabilityScorer creates a new ReadabilityScorer.
+func NewReadabilityScorer() *ReadabilityScorer {
+	return &ReadabilityScorer{}
+}
+
+// CalculateScore computes the Flesch Reading Ease score for text:
+// 206.835 - 1.015*(words/sentences) - 84.6*(syllables/words).
+func (s *ReadabilityScorer) CalculateScore(text string) int {
+	words := strings.Fields(text)
+	wordCount := len(words)
+	if wordCount == 0 {
+		return 0
+	}
+
+	sentenceCounter := &SentenceScorer{}
+	sentenceCount := sentenceCounter.CountSentences(text)
+	if sentenceCount == 0 {
+		sentenceCount = 1
+	}
+
+	syllableCount := 0
+	for _, word := range words {
+		syllableCount += countSyllables(word)
+	}
+
+	score := 206.835 - 1.015*(float64(wordCount)/float64(sentenceCount)) - 84.6*(float64(syllableCount)/float64(wordCount))
+
+	if score < 0 {
+		score = 0
+	}
+	if score > 100 {
+		score = 100
+	}
+	return int(math.Round(score))
+}
+
+// Name identifies this scorer as the "readability" strategy.
+func (s *ReadabilityScorer) Name() string {
+	return "readability"
+}
+
+// vowelGroup matches a run of vowels, used to approximate syllable count.
+var vowelGroup = regexp.MustCompile(`(?i)[aeiouy]+`)
+
+// countSyllables estimates the number of syllables in word by counting
+// vowel groups, a common heuristic for Flesch-style readability formulas.
+func countSyllables(word string) int {
+	word = strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !('a' <= r && r <= 'z') && !('A' <= r && r <= 'Z')
+	}))
+	if word == "" {
+		return 0
+	}
+
+	count := len(vowelGroup.FindAllString(word, -1))
+	if strings.HasSuffix(word, "e") && count > 1 {
+		count--
+	}
+	if count == 0 {
+		count = 1
+	}
+	return count
+}
+
+// ScorerRegistry holds the set of registered scoring strategies, keyed by
+// name.
+type ScorerRegistry struct {
+	scorers map[string]Scorer
+}
+
+// NewScorerRegistry creates a ScorerRegistry containing the given scorers.
+func NewScorerRegistry(scorers ...Scorer) *ScorerRegistry {
+	registry := &ScorerRegistry{scorers: make(map[string]Scorer, len(scorers))}
+	for _, scorer := range scorers {
+		registry.scorers[scorer.Name()] = scorer
+	}
+	return registry
+}
+
+// Get returns the scorer registered under name, if any.
+func (r *ScorerRegistry) Get(name string) (Scorer, bool) {
+	scorer, ok := r.scorers[name]
+	return scorer, ok
+}
+
+// Names returns the names of all registered scorers.
+func (r *ScorerRegistry) Names() []string {
+	names := make([]string, 0, len(r.scorers))
+	for name := range r.scorers {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/http2"
+)
+
+// shutdownTimeout bounds how long graceful shutdown waits for in-flight
+// requests to complete.
+const shutdownTimeout = 15 * time.Second
+
+// http2MaxConcurrentStreams is the stream limit negotiated with HTTP/2
+// clients on TLS deployments.
+const http2MaxConcurrentStreams = 64
+
+// HTTPConfig holds the flag/env driven settings for the HTTP server.
+type HTTPConfig struct {
+	Port              string
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	CORSAllowOrigins  []string
+}
+
+// httpFlags holds the flag values backing HTTPConfig until flag.Parse runs.
+type httpFlags struct {
+	port              *string
+	readTimeout       *time.Duration
+	readHeaderTimeout *time.Duration
+	writeTimeout      *time.Duration
+	idleTimeout       *time.Duration
+	corsAllowOrigins  *string
+}
+
+// registerHTTPFlags registers the HTTP server flags, falling back to
+// environment variables and then sane defaults.
+func registerHTTPFlags() httpFlags {
+	return httpFlags{
+		port:              flag.String("http-port", envOrDefault("HTTP_PORT", "8080"), "port to listen on"),
+		readTimeout:       flag.Duration("http-read-timeout", envDurationOrDefault("HTTP_READ_TIMEOUT", 30*time.Second), "maximum duration for reading the entire request"),
+		readHeaderTimeout: flag.Duration("http-read-header-timeout", envDurationOrDefault("HTTP_READ_HEADER_TIMEOUT", 10*time.Second), "maximum duration for reading request headers"),
+		writeTimeout:      flag.Duration("http-write-timeout", envDurationOrDefault("HTTP_WRITE_TIMEOUT", 30*time.Second), "maximum duration before timing out writes of the response"),
+		idleTimeout:       flag.Duration("http-idle-timeout", envDurationOrDefault("HTTP_IDLE_TIMEOUT", 120*time.Second), "maximum amount of time to wait for the next request on keep-alive connections"),
+		corsAllowOrigins:  flag.String("http-cors-allow-origins", envOrDefault("HTTP_CORS_ALLOW_ORIGINS", ""), "comma-separated list of origins allowed to make cross-origin requests"),
+	}
+}
+
+// resolve converts the parsed flag values into an HTTPConfig. Call after
+// flag.Parse.
+func (f httpFlags) resolve() HTTPConfig {
+	var origins []string
+	if *f.corsAllowOrigins != "" {
+		origins = strings.Split(*f.corsAllowOrigins, ",")
+	}
+
+	return HTTPConfig{
+		Port:              *f.port,
+		ReadTimeout:       *f.readTimeout,
+		ReadHeaderTimeout: *f.readHeaderTimeout,
+		WriteTimeout:      *f.writeTimeout,
+		IdleTimeout:       *f.idleTimeout,
+		CORSAllowOrigins:  origins,
+	}
+}
+
+// useCORS registers the CORS middleware on router when an allow-list is
+// configured. Gin snapshots a route's handler chain at registration time, so
+// this must be called before any routes are declared or it has no effect.
+func useCORS(config HTTPConfig, router *gin.Engine) {
+	if len(config.CORSAllowOrigins) == 0 {
+		return
+	}
+	router.Use(cors.New(cors.Config{
+		AllowOrigins: config.CORSAllowOrigins,
+		AllowMethods: []string{"GET", "POST", "PUT", "DELETE"},
+		AllowHeaders: []string{"Authorization", "Content-Type"},
+	}))
+}
+
+// newHTTPServer wraps router with gzip compression, configures it for
+// HTTP/2, and applies the timeouts from config.
+func newHTTPServer(config HTTPConfig, router *gin.Engine) *http.Server {
+	handler := gziphandler.GzipHandler(router)
+
+	server := &http.Server{
+		Addr:              ":" + config.Port,
+		Handler:           handler,
+		ReadTimeout:       config.ReadTimeout,
+		ReadHeaderTimeout: config.ReadHeaderTimeout,
+		WriteTimeout:      config.WriteTimeout,
+		IdleTimeout:       config.IdleTimeout,
+	}
+
+	http2.ConfigureServer(server, &http2.Server{
+		MaxConcurrentStreams: http2MaxConcurrentStreams,
+	})
+
+	return server
+}
+
+// runWithGracefulShutdown starts server and blocks until it exits, shutting
+// it down cleanly on SIGINT/SIGTERM so in-flight requests can complete.
+func runWithGracefulShutdown(server *http.Server) error {
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- server.ListenAndServe()
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-serveErrCh:
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	case <-sigCh:
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return server.Shutdown(ctx)
+	}
+}
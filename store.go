@@ -0,0 +1,161 @@
+package main
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrDocumentNotFound is returned by a Store when no document matches the
+// requested ID.
+var ErrDocumentNotFound = errors.New("store: document not found")
+
+// Document is a scored piece of text persisted by a Store.
+type Document struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Text      string    `json:"documentText"`
+	Score     int       `json:"score"`
+	WordCount int       `json:"wordCount"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Store persists documents. InMemoryStore is the default implementation;
+// a Postgres-backed implementation can satisfy the same interface.
+type Store interface {
+	Insert(doc *Document) error
+	Get(id string) (*Document, error)
+	Update(doc *Document) error
+	Delete(id string) error
+	List(filters Filters) ([]*Document, int, error)
+}
+
+// InMemoryStore is a Store backed by an in-process map. It's the default
+// store used when no external database is configured.
+type InMemoryStore struct {
+	mu     sync.RWMutex
+	docs   map[string]*Document
+	nextID int
+}
+
+// NewInMemoryStore creates an empty InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		docs: make(map[string]*Document),
+	}
+}
+
+// Insert assigns doc a new ID, replacing any ID it already had, and stores a
+// copy of it, so the map never shares a pointer with the caller.
+func (s *InMemoryStore) Insert(doc *Document) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	doc.ID = strconv.Itoa(s.nextID)
+	copied := *doc
+	s.docs[doc.ID] = &copied
+	return nil
+}
+
+// Get returns a copy of the document with the given ID. Callers are free to
+// mutate the result without racing the store's internal state.
+func (s *InMemoryStore) Get(id string) (*Document, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	doc, ok := s.docs[id]
+	if !ok {
+		return nil, ErrDocumentNotFound
+	}
+	copied := *doc
+	return &copied, nil
+}
+
+// Update replaces the stored document sharing doc.ID with a copy of doc,
+// under the write lock, so concurrent readers never observe a partially
+// mutated document.
+func (s *InMemoryStore) Update(doc *Document) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.docs[doc.ID]; !ok {
+		return ErrDocumentNotFound
+	}
+	copied := *doc
+	s.docs[doc.ID] = &copied
+	return nil
+}
+
+// Delete removes the document with the given ID.
+func (s *InMemoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.docs[id]; !ok {
+		return ErrDocumentNotFound
+	}
+	delete(s.docs, id)
+	return nil
+}
+
+// List returns documents matching filters, along with the total number of
+// matching records (before pagination is applied).
+func (s *InMemoryStore) List(filters Filters) ([]*Document, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := make([]*Document, 0, len(s.docs))
+	for _, doc := range s.docs {
+		if filters.Title != "" && !strings.Contains(strings.ToLower(doc.Title), strings.ToLower(filters.Title)) {
+			continue
+		}
+		if doc.Score < filters.MinScore {
+			continue
+		}
+		copied := *doc
+		matched = append(matched, &copied)
+	}
+
+	total := len(matched)
+	sortDocuments(matched, filters.Sort)
+
+	start := (filters.Page - 1) * filters.PageSize
+	if start >= len(matched) {
+		return []*Document{}, total, nil
+	}
+	end := start + filters.PageSize
+	if end > len(matched) {
+		end = len(matched)
+	}
+	return matched[start:end], total, nil
+}
+
+// sortDocuments sorts docs in place according to sortField, which is a
+// column name optionally prefixed with "-" for descending order.
+func sortDocuments(docs []*Document, sortField string) {
+	descending := strings.HasPrefix(sortField, "-")
+	column := strings.TrimPrefix(sortField, "-")
+
+	less := func(i, j int) bool {
+		switch column {
+		case "title":
+			return docs[i].Title < docs[j].Title
+		case "created_at":
+			return docs[i].CreatedAt.Before(docs[j].CreatedAt)
+		default: // "score"
+			return docs[i].Score < docs[j].Score
+		}
+	}
+
+	sort.Slice(docs, func(i, j int) bool {
+		if descending {
+			return less(j, i)
+		}
+		return less(i, j)
+	})
+}
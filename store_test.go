@@ -0,0 +1,44 @@
+package main
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestInMemoryStoreConcurrentGetAndUpdateDoesNotRace(t *testing.T) {
+	store := NewInMemoryStore()
+	if err := store.Insert(&Document{Title: "original", Text: "one two three"}); err != nil {
+		t.Fatalf("Insert: %v", err)
+	}
+
+	docs, _, err := store.List(Filters{Page: 1, PageSize: 10})
+	if err != nil || len(docs) != 1 {
+		t.Fatalf("List: %v, %d docs", err, len(docs))
+	}
+	id := docs[0].ID
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(n int) {
+			defer wg.Done()
+			doc, err := store.Get(id)
+			if err != nil {
+				t.Errorf("Get: %v", err)
+				return
+			}
+			doc.Title = "updated"
+			doc.Score = n
+			if err := store.Update(doc); err != nil {
+				t.Errorf("Update: %v", err)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			if _, _, err := store.List(Filters{Page: 1, PageSize: 10}); err != nil {
+				t.Errorf("List: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// WebSocket tuning constants, following the standard gorilla/websocket
+// chat-server pattern.
+const (
+	wsWriteWait      = 10 * time.Second
+	wsPongWait       = 60 * time.Second
+	wsPingPeriod     = (wsPongWait * 9) / 10
+	wsMaxMessageSize = 4096
+)
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// Hub tracks connected live-scoring clients.
+type Hub struct {
+	mu      sync.Mutex
+	clients map[*Client]bool
+}
+
+// NewHub creates an empty Hub.
+func NewHub() *Hub {
+	return &Hub{clients: make(map[*Client]bool)}
+}
+
+func (h *Hub) register(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[c] = true
+}
+
+func (h *Hub) unregister(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients, c)
+}
+
+// Client is a single live-scoring WebSocket connection.
+type Client struct {
+	hub        *Hub
+	conn       *websocket.Conn
+	scorer     *WordScorer
+	send       chan ScoreResponse
+	done       chan struct{}
+	debounceMs int
+}
+
+// readPump receives text fragments from the client, debounces them, scores
+// each one, and queues the response for writePump.
+func (c *Client) readPump() {
+	var debounce *time.Timer
+	defer func() {
+		if debounce != nil {
+			debounce.Stop()
+		}
+		c.hub.unregister(c)
+		close(c.done)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(wsMaxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		return nil
+	})
+
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				log.Printf("ws: read error: %v", err)
+			}
+			return
+		}
+
+		text := string(message)
+		if c.debounceMs <= 0 {
+			c.scoreAndSend(text)
+			continue
+		}
+
+		if debounce != nil {
+			debounce.Stop()
+		}
+		debounce = time.AfterFunc(time.Duration(c.debounceMs)*time.Millisecond, func() {
+			c.scoreAndSend(text)
+		})
+	}
+}
+
+// scoreAndSend scores text and queues the result on the client's send
+// channel, dropping it if the client isn't keeping up or has disconnected.
+func (c *Client) scoreAndSend(text string) {
+	response := ScoreResponse{
+		WordCount: c.scorer.CountWords(text),
+		Score:     c.scorer.CalculateScore(text),
+		Message:   "Scoring successful",
+	}
+	select {
+	case c.send <- response:
+	case <-c.done:
+	default:
+		log.Printf("ws: client send buffer full, dropping update")
+	}
+}
+
+// writePump sends queued ScoreResponse frames to the client and pings it
+// periodically to keep the connection alive.
+func (c *Client) writePump() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case response := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := c.conn.WriteJSON(response); err != nil {
+				return
+			}
+		case <-c.done:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+			return
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ServeWS upgrades the connection to a WebSocket and starts the client's
+// read and write pumps. debounceMs coalesces rapid input from the client
+// before it's scored.
+func (h *Hub) ServeWS(scorer *WordScorer, c *gin.Context) {
+	debounceMs, _ := strconv.Atoi(c.Query("debounceMs"))
+
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Printf("ws: upgrade failed: %v", err)
+		return
+	}
+
+	client := &Client{
+		hub:        h,
+		conn:       conn,
+		scorer:     scorer,
+		send:       make(chan ScoreResponse, 16),
+		done:       make(chan struct{}),
+		debounceMs: debounceMs,
+	}
+	h.register(client)
+
+	go client.writePump()
+	go client.readPump()
+}